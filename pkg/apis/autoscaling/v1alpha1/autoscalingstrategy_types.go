@@ -0,0 +1,60 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AutoscalingStrategy is a cluster-scoped kill-switch: it gates whether
+// every HorizontalAutoscaler in the cluster is allowed to mutate its scale
+// target, and can override global min/max bounds and the rate at which
+// recommendations are allowed to move.
+type AutoscalingStrategy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoscalingStrategySpec   `json:"spec,omitempty"`
+	Status AutoscalingStrategyStatus `json:"status,omitempty"`
+}
+
+// AutoscalingStrategySpec configures the cluster-wide scaling gate.
+type AutoscalingStrategySpec struct {
+	// Enabled controls whether HorizontalAutoscalers are allowed to
+	// mutate their scale target's replica count. Nil and true behave the
+	// same; when explicitly set to false, recommendations, status
+	// conditions and metrics continue to be computed so operators can
+	// observe what would happen, but scaleTarget.Spec.Replicas is left
+	// untouched. Defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+	// MinReplicas, if set, overrides every HorizontalAutoscaler's
+	// Spec.MinReplicas with this cluster-wide floor.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas, if set, overrides every HorizontalAutoscaler's
+	// Spec.MaxReplicas with this cluster-wide ceiling.
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// ScalingRateMultiplierPercent scales how much change each HPA's
+	// Behavior.ScaleUp/ScaleDown policies allow per period, e.g. 50 halves
+	// the permitted rate of change during an incident. Defaults to 100
+	// (no adjustment).
+	ScalingRateMultiplierPercent *int32 `json:"scalingRateMultiplierPercent,omitempty"`
+}
+
+// AutoscalingStrategyStatus reflects the last observed state.
+type AutoscalingStrategyStatus struct {
+	// ObservedGeneration is the most recent generation observed by the
+	// reconciler.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
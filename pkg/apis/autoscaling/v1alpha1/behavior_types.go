@@ -0,0 +1,172 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	f "github.com/awslabs/karpenter/pkg/utils/functional"
+	"knative.dev/pkg/apis"
+)
+
+// DefaultStabilizationWindowSeconds is used when a direction's
+// StabilizationWindowSeconds is left unset.
+const DefaultStabilizationWindowSeconds int32 = 300
+
+// HorizontalAutoscalerBehavior configures the scaling behavior for the
+// scale up and scale down directions independently, mirroring
+// autoscaling/v2beta2's HorizontalPodAutoscalerBehavior.
+type HorizontalAutoscalerBehavior struct {
+	// ScaleUp governs how replicas are scaled up.
+	ScaleUp *HPAScalingRules `json:"scaleUp,omitempty"`
+	// ScaleDown governs how replicas are scaled down.
+	ScaleDown *HPAScalingRules `json:"scaleDown,omitempty"`
+	// ScaleUpConsecutiveThreshold is the number of consecutive reconciles
+	// that must recommend scaling up before scaleTarget.Spec.Replicas is
+	// actually changed. Defaults to 1 (no hysteresis).
+	ScaleUpConsecutiveThreshold *int32 `json:"scaleUpConsecutiveThreshold,omitempty"`
+	// ScaleDownConsecutiveThreshold is the number of consecutive reconciles
+	// that must recommend scaling down before scaleTarget.Spec.Replicas is
+	// actually changed. Defaults to 1 (no hysteresis).
+	ScaleDownConsecutiveThreshold *int32 `json:"scaleDownConsecutiveThreshold,omitempty"`
+	// MetricsSelectPolicy chooses how per-metric recommendations are
+	// reduced to a single desired replica count. Defaults to Max.
+	MetricsSelectPolicy *MetricsSelectPolicy `json:"metricsSelectPolicy,omitempty"`
+}
+
+// HPAScalingRules bounds the rate at which replicas can be changed in a
+// single direction.
+type HPAScalingRules struct {
+	// StabilizationWindowSeconds is the number of seconds for which past
+	// recommendations should be considered while scaling.
+	StabilizationWindowSeconds *int32 `json:"stabilizationWindowSeconds,omitempty"`
+	// SelectPolicy chooses the policy used when multiple Policies are
+	// specified. Defaults to Max.
+	SelectPolicy *ScalingPolicySelect `json:"selectPolicy,omitempty"`
+	// Policies is a list of potential scaling polices which can be used
+	// during scaling.
+	Policies []HPAScalingPolicy `json:"policies,omitempty"`
+}
+
+// ScalingPolicySelect selects which policy to use when multiple policies
+// could apply.
+type ScalingPolicySelect string
+
+const (
+	// MaxPolicySelect selects the policy with the highest possible change.
+	MaxPolicySelect ScalingPolicySelect = "Max"
+	// MinPolicySelect selects the policy with the lowest possible change.
+	MinPolicySelect ScalingPolicySelect = "Min"
+	// DisabledPolicySelect disables scaling in this direction.
+	DisabledPolicySelect ScalingPolicySelect = "Disabled"
+)
+
+// HPAScalingPolicyType is the type of the policy which could be used while
+// making scaling decisions.
+type HPAScalingPolicyType string
+
+const (
+	// PodsScalingPolicy allows a fixed number of pods to be added or removed
+	// in the period.
+	PodsScalingPolicy HPAScalingPolicyType = "Pods"
+	// PercentScalingPolicy allows a percentage of the current replica count
+	// to be added or removed in the period.
+	PercentScalingPolicy HPAScalingPolicyType = "Percent"
+)
+
+// HPAScalingPolicy is a single policy which must hold true for a specified
+// past interval.
+type HPAScalingPolicy struct {
+	// Type identifies the scaling policy.
+	Type HPAScalingPolicyType `json:"type"`
+	// Value contains the amount of change allowed by the policy: number of
+	// pods for PodsScalingPolicy, percentage for PercentScalingPolicy.
+	Value int32 `json:"value"`
+	// PeriodSeconds is the window over which the policy should be
+	// evaluated.
+	PeriodSeconds int32 `json:"periodSeconds"`
+}
+
+// GetScalingRules returns the HPAScalingRules that apply for the scale
+// direction implied by comparing replicas against recommendations,
+// defaulting any unset fields.
+func (b *HorizontalAutoscalerBehavior) GetScalingRules(replicas int32, recommendations []int32) *HPAScalingRules {
+	recommendation := f.MaxInt32(recommendations)
+
+	var rules *HPAScalingRules
+	if recommendation > replicas {
+		rules = b.ScaleUp
+	} else {
+		rules = b.ScaleDown
+	}
+
+	if rules == nil {
+		rules = &HPAScalingRules{}
+	}
+	defaulted := *rules
+	if defaulted.StabilizationWindowSeconds == nil {
+		window := DefaultStabilizationWindowSeconds
+		defaulted.StabilizationWindowSeconds = &window
+	}
+	if defaulted.SelectPolicy == nil {
+		selectPolicy := MaxPolicySelect
+		defaulted.SelectPolicy = &selectPolicy
+	}
+	return &defaulted
+}
+
+// WithinStabilizationWindow reports whether lastScaleTime is within the
+// rules' stabilization window, during which scaling should be suppressed.
+func (r *HPAScalingRules) WithinStabilizationWindow(lastScaleTime *apis.VolatileTime) bool {
+	if lastScaleTime == nil {
+		return false
+	}
+	window := DefaultStabilizationWindowSeconds
+	if r.StabilizationWindowSeconds != nil {
+		window = *r.StabilizationWindowSeconds
+	}
+	return time.Since(lastScaleTime.Inner.Time) < time.Duration(window)*time.Second
+}
+
+// ApplySelectPolicy reduces the per-metric recommendations to a single
+// desired replica count, using MetricsSelectPolicy (defaulting to Max).
+func (b *HorizontalAutoscalerBehavior) ApplySelectPolicy(currentReplicas int32, recommendations []WeightedRecommendation) int32 {
+	if len(recommendations) == 0 {
+		return currentReplicas
+	}
+
+	policy := MaxMetricsSelect
+	if b.MetricsSelectPolicy != nil {
+		policy = *b.MetricsSelectPolicy
+	}
+
+	values := make([]int32, len(recommendations))
+	for i, recommendation := range recommendations {
+		values[i] = recommendation.Value
+	}
+
+	switch policy {
+	case MinMetricsSelect:
+		return f.MinInt32(values)
+	case MedianMetricsSelect:
+		return median(values)
+	case AverageMetricsSelect:
+		return average(values)
+	case WeightedMetricsSelect:
+		return weightedAverage(recommendations)
+	default: // MaxMetricsSelect
+		return f.MaxInt32(values)
+	}
+}
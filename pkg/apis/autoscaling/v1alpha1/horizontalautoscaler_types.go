@@ -0,0 +1,100 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+// AbleToScale indicates that the HPA controller is able to scale if
+// necessary: it's correctly configured, can fetch the desired metric, not
+// in a backoff window, and isn't disabled.
+const AbleToScale apis.ConditionType = "AbleToScale"
+
+// ScalingUnbounded indicates that the calculated desired replicas fell
+// within the configured min/max bounds.
+const ScalingUnbounded apis.ConditionType = "ScalingUnbounded"
+
+var conditionSet = apis.NewLivingConditionSet(AbleToScale, ScalingUnbounded)
+
+// HorizontalAutoscaler scales a target resource's replica count based on
+// observed metrics.
+type HorizontalAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HorizontalAutoscalerSpec   `json:"spec,omitempty"`
+	Status HorizontalAutoscalerStatus `json:"status,omitempty"`
+}
+
+// HorizontalAutoscalerSpec describes the desired scaling behavior.
+type HorizontalAutoscalerSpec struct {
+	// ScaleTargetRef points at the resource being scaled.
+	ScaleTargetRef CrossVersionObjectReference `json:"scaleTargetRef"`
+	// MinReplicas is the lower bound for the number of replicas.
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper bound for the number of replicas.
+	MaxReplicas int32 `json:"maxReplicas"`
+	// Metrics is the set of metrics used to calculate the desired replica count.
+	Metrics []Metric `json:"metrics,omitempty"`
+	// Behavior configures the scaling behavior of the target.
+	Behavior HorizontalAutoscalerBehavior `json:"behavior,omitempty"`
+}
+
+// HorizontalAutoscalerStatus reflects the last observed state.
+type HorizontalAutoscalerStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastScaleTime is the last time the scale target was scaled.
+	LastScaleTime *apis.VolatileTime `json:"lastScaleTime,omitempty"`
+	// CurrentReplicas is the current number of replicas on the scale target.
+	CurrentReplicas *int32 `json:"currentReplicas,omitempty"`
+	// DesiredReplicas is the replica count most recently computed for the
+	// scale target. It is recorded before the scale target is updated, and
+	// also when an AutoscalingStrategy keeps it from being applied.
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+	// ConsecutiveVotes reports progress towards the consecutive-threshold
+	// hysteresis, e.g. "2/3 consecutive scale-up votes". Empty when the
+	// last recommendation was neutral. With no threshold configured, the
+	// threshold shown is 1.
+	ConsecutiveVotes string `json:"consecutiveVotes,omitempty"`
+	// Conditions contains the latest observations of the autoscaler's state.
+	Conditions apis.Conditions `json:"conditions,omitempty"`
+}
+
+// CrossVersionObjectReference identifies another object by name, API
+// version, and kind.
+type CrossVersionObjectReference struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// GetConditions implements apis.ConditionsAccessor.
+func (ha *HorizontalAutoscaler) GetConditions() apis.Conditions {
+	return ha.Status.Conditions
+}
+
+// SetConditions implements apis.ConditionsAccessor.
+func (ha *HorizontalAutoscaler) SetConditions(conditions apis.Conditions) {
+	ha.Status.Conditions = conditions
+}
+
+// StatusConditions returns a ConditionManager for mutating this
+// HorizontalAutoscaler's status conditions.
+func (ha *HorizontalAutoscaler) StatusConditions() apis.ConditionManager {
+	return conditionSet.Manage(ha)
+}
@@ -0,0 +1,53 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v2beta2 "k8s.io/api/autoscaling/v2beta2"
+)
+
+// Metric wraps the upstream autoscaling/v2beta2 metric spec types, adding
+// GetTarget so callers don't need to switch on the metric source to find
+// the configured target.
+type Metric struct {
+	v2beta2.MetricSpec `json:",inline"`
+
+	// Weight is used by the Weighted select policy to compose this
+	// metric's recommendation with the others. Ignored otherwise.
+	Weight int32 `json:"weight,omitempty"`
+	// DisableScaleUp suppresses this metric's contribution to scale-up
+	// decisions: if its recommendation would scale up, it's clamped to the
+	// current replica count instead.
+	DisableScaleUp bool `json:"disableScaleUp,omitempty"`
+	// DisableScaleDown suppresses this metric's contribution to scale-down
+	// decisions: if its recommendation would scale down, it's clamped to
+	// the current replica count instead.
+	DisableScaleDown bool `json:"disableScaleDown,omitempty"`
+}
+
+// GetTarget returns the MetricTarget for whichever metric source is set.
+func (m Metric) GetTarget() v2beta2.MetricTarget {
+	switch m.Type {
+	case v2beta2.ResourceMetricSourceType:
+		return m.Resource.Target
+	case v2beta2.PodsMetricSourceType:
+		return m.Pods.Target
+	case v2beta2.ObjectMetricSourceType:
+		return m.Object.Target
+	case v2beta2.ExternalMetricSourceType:
+		return m.External.Target
+	}
+	return v2beta2.MetricTarget{}
+}
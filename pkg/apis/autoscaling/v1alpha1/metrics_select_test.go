@@ -0,0 +1,147 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestMedian(t *testing.T) {
+	cases := []struct {
+		name   string
+		values []int32
+		want   int32
+	}{
+		{name: "odd", values: []int32{1, 5, 3}, want: 3},
+		{name: "even", values: []int32{1, 2, 3, 4}, want: 3},
+		{name: "single", values: []int32{7}, want: 7},
+		{name: "unsorted", values: []int32{10, 1, 4}, want: 4},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := median(c.values); got != c.want {
+				t.Errorf("median(%v) = %d, want %d", c.values, got, c.want)
+			}
+		})
+	}
+}
+
+func TestAverage(t *testing.T) {
+	cases := []struct {
+		name   string
+		values []int32
+		want   int32
+	}{
+		{name: "exact", values: []int32{2, 4, 6}, want: 4},
+		{name: "rounds up", values: []int32{1, 2}, want: 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := average(c.values); got != c.want {
+				t.Errorf("average(%v) = %d, want %d", c.values, got, c.want)
+			}
+		})
+	}
+}
+
+func TestWeightedAverage(t *testing.T) {
+	cases := []struct {
+		name            string
+		recommendations []WeightedRecommendation
+		want            int32
+	}{
+		{
+			name: "weighted",
+			recommendations: []WeightedRecommendation{
+				{Value: 10, Weight: 1},
+				{Value: 20, Weight: 3},
+			},
+			want: 18,
+		},
+		{
+			name: "falls back to equal weight when omitted",
+			recommendations: []WeightedRecommendation{
+				{Value: 2},
+				{Value: 4},
+			},
+			want: 3,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := weightedAverage(c.recommendations); got != c.want {
+				t.Errorf("weightedAverage(%v) = %d, want %d", c.recommendations, got, c.want)
+			}
+		})
+	}
+}
+
+func TestApplySelectPolicy(t *testing.T) {
+	minPolicy := MinMetricsSelect
+	medianPolicy := MedianMetricsSelect
+	averagePolicy := AverageMetricsSelect
+	weightedPolicy := WeightedMetricsSelect
+
+	cases := []struct {
+		name            string
+		policy          *MetricsSelectPolicy
+		recommendations []WeightedRecommendation
+		want            int32
+	}{
+		{
+			name:            "defaults to max",
+			policy:          nil,
+			recommendations: []WeightedRecommendation{{Value: 3}, {Value: 7}},
+			want:            7,
+		},
+		{
+			name:            "min",
+			policy:          &minPolicy,
+			recommendations: []WeightedRecommendation{{Value: 3}, {Value: 7}},
+			want:            3,
+		},
+		{
+			name:            "median",
+			policy:          &medianPolicy,
+			recommendations: []WeightedRecommendation{{Value: 1}, {Value: 5}, {Value: 3}},
+			want:            3,
+		},
+		{
+			name:            "average",
+			policy:          &averagePolicy,
+			recommendations: []WeightedRecommendation{{Value: 2}, {Value: 4}, {Value: 6}},
+			want:            4,
+		},
+		{
+			name:            "weighted",
+			policy:          &weightedPolicy,
+			recommendations: []WeightedRecommendation{{Value: 10, Weight: 1}, {Value: 20, Weight: 3}},
+			want:            18,
+		},
+		{
+			name:            "no recommendations returns current replicas",
+			policy:          &weightedPolicy,
+			recommendations: nil,
+			want:            5,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			behavior := HorizontalAutoscalerBehavior{MetricsSelectPolicy: c.policy}
+			if got := behavior.ApplySelectPolicy(5, c.recommendations); got != c.want {
+				t.Errorf("ApplySelectPolicy() = %d, want %d", got, c.want)
+			}
+		})
+	}
+}
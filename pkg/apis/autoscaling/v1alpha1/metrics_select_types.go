@@ -0,0 +1,86 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"math"
+	"sort"
+)
+
+// MetricsSelectPolicy chooses how per-metric recommendations are combined
+// into a single desired replica count.
+type MetricsSelectPolicy string
+
+const (
+	// MaxMetricsSelect takes the highest per-metric recommendation. This is
+	// the upstream HPA default: scaling is driven by whichever metric wants
+	// the most replicas.
+	MaxMetricsSelect MetricsSelectPolicy = "Max"
+	// MinMetricsSelect takes the lowest per-metric recommendation.
+	MinMetricsSelect MetricsSelectPolicy = "Min"
+	// MedianMetricsSelect takes the median per-metric recommendation.
+	MedianMetricsSelect MetricsSelectPolicy = "Median"
+	// AverageMetricsSelect takes the mean per-metric recommendation.
+	AverageMetricsSelect MetricsSelectPolicy = "Average"
+	// WeightedMetricsSelect combines per-metric recommendations using each
+	// metric's Weight.
+	WeightedMetricsSelect MetricsSelectPolicy = "Weighted"
+)
+
+// WeightedRecommendation is a single metric's recommended replica count,
+// along with the weight configured on that metric for use by the
+// Weighted MetricsSelectPolicy.
+type WeightedRecommendation struct {
+	Value  int32
+	Weight int32
+}
+
+func median(values []int32) int32 {
+	sorted := append([]int32{}, values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return int32(math.Round(float64(sorted[mid-1]+sorted[mid]) / 2))
+}
+
+func average(values []int32) int32 {
+	var sum int64
+	for _, value := range values {
+		sum += int64(value)
+	}
+	return int32(math.Round(float64(sum) / float64(len(values))))
+}
+
+// weightedAverage computes round(sum(weight_i * value_i) / sum(weight_i)).
+// Recommendations with no weight set fall back to an equal weight of 1, so
+// Weighted degrades gracefully to Average when weights are omitted.
+func weightedAverage(recommendations []WeightedRecommendation) int32 {
+	var weightedSum int64
+	var totalWeight int64
+	for _, recommendation := range recommendations {
+		weight := recommendation.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		weightedSum += int64(weight) * int64(recommendation.Value)
+		totalWeight += int64(weight)
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return int32(math.Round(float64(weightedSum) / float64(totalWeight)))
+}
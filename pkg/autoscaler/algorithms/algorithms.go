@@ -0,0 +1,63 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package algorithms
+
+import (
+	"math"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+	v2beta2 "k8s.io/api/autoscaling/v2beta2"
+)
+
+// Metric is the observed value for a single metric, normalized to the
+// target type and value configured on the HorizontalAutoscaler.
+type Metric struct {
+	Metric      float64
+	TargetType  v2beta2.MetricTargetType
+	TargetValue float64
+	// Weight is carried through from v1alpha1.Metric for the Weighted
+	// MetricsSelectPolicy.
+	Weight int32
+	// DisableScaleUp/DisableScaleDown are carried through from
+	// v1alpha1.Metric so the recommendation built from this metric can be
+	// clamped to the current replica count in that direction.
+	DisableScaleUp   bool
+	DisableScaleDown bool
+}
+
+// Algorithm computes the desired replica count for a single metric
+// observation given the current number of replicas.
+type Algorithm interface {
+	GetDesiredReplicas(metric Metric, currentReplicas int32) int32
+}
+
+// For returns the Algorithm used to convert metric observations into a
+// desired replica count for the given spec.
+func For(spec v1alpha1.HorizontalAutoscalerSpec) Algorithm {
+	return &ratioAlgorithm{}
+}
+
+// ratioAlgorithm scales replicas proportionally to how far the observed
+// metric value is from its target, the same ratio used by the upstream
+// HorizontalPodAutoscaler.
+type ratioAlgorithm struct{}
+
+func (r *ratioAlgorithm) GetDesiredReplicas(metric Metric, currentReplicas int32) int32 {
+	if metric.TargetValue == 0 {
+		return currentReplicas
+	}
+	ratio := metric.Metric / metric.TargetValue
+	return int32(math.Ceil(float64(currentReplicas) * ratio))
+}
@@ -28,14 +28,16 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/scale"
 	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func NewFactoryOrDie(metricsclientfactory *clients.Factory, mapper meta.RESTMapper, config *rest.Config) *Factory {
+func NewFactoryOrDie(metricsclientfactory *clients.Factory, mapper meta.RESTMapper, config *rest.Config, cachedClient client.Client) *Factory {
 	discoveryClient := discovery.NewDiscoveryClientForConfigOrDie(config)
 	scalesgetter := scale.New(
 		discoveryClient.RESTClient(),
@@ -47,6 +49,10 @@ func NewFactoryOrDie(metricsclientfactory *clients.Factory, mapper meta.RESTMapp
 		MetricsClientFactory: metricsclientfactory,
 		Mapper:               mapper,
 		ScalesGetter:         scalesgetter,
+		CachedClient:         cachedClient,
+		history:              newScaleHistory(),
+		votes:                newConsecutiveVotes(),
+		strategy:             newStrategyStore(),
 	}
 }
 
@@ -55,6 +61,22 @@ type Factory struct {
 	MetricsClientFactory *clients.Factory
 	Mapper               meta.RESTMapper
 	ScalesGetter         scale.ScalesGetter
+	// CachedClient is an informer-backed client used to read/write
+	// well-known scale targets (Deployment, StatefulSet, ReplicaSet)
+	// without a live scales.Get/Update round-trip. It may be nil, in
+	// which case every scale target goes through ScalesGetter.
+	CachedClient client.Client
+	// history is shared across every Autoscaler the Factory produces, so
+	// that the rolling record of scale events for a given HPA survives
+	// across reconciles.
+	history *scaleHistory
+	// votes is shared across every Autoscaler the Factory produces, so
+	// that consecutive-threshold counters for a given HPA survive across
+	// reconciles.
+	votes *consecutiveVotes
+	// strategy holds the cluster's active AutoscalingStrategy, if any, as
+	// observed by AutoscalingStrategyReconciler.
+	strategy *strategyStore
 }
 
 // For returns an autoscaler for the resource
@@ -65,6 +87,10 @@ func (f *Factory) For(resource *v1alpha1.HorizontalAutoscaler) Autoscaler {
 		metricsClientFactory: f.MetricsClientFactory,
 		mapper:               f.Mapper,
 		scalesGetter:         f.ScalesGetter,
+		cachedClient:         f.CachedClient,
+		history:              f.history,
+		votes:                f.votes,
+		strategy:             f.strategy,
 	}
 }
 
@@ -75,6 +101,16 @@ type Autoscaler struct {
 	algorithm            algorithms.Algorithm
 	mapper               meta.RESTMapper
 	scalesGetter         scale.ScalesGetter
+	cachedClient         client.Client
+	history              *scaleHistory
+	votes                *consecutiveVotes
+	strategy             *strategyStore
+}
+
+// namespacedName identifies this HPA for purposes of keying in-memory,
+// per-HPA state such as scale history.
+func (a *Autoscaler) namespacedName() types.NamespacedName {
+	return types.NamespacedName{Namespace: a.ObjectMeta.Namespace, Name: a.ObjectMeta.Name}
 }
 
 // Reconcile executes an autoscaling loop
@@ -94,20 +130,30 @@ func (a *Autoscaler) Reconcile() error {
 
 	// 3. Calculate desired replicas using metrics and current desired replicas
 	desiredReplicas := a.getDesiredReplicas(metrics, scaleTarget)
+	a.Status.DesiredReplicas = &desiredReplicas
 	if desiredReplicas == scaleTarget.Spec.Replicas {
 		return nil
 	}
 
+	// An AutoscalingStrategy may disable scaling cluster-wide; recommendations,
+	// conditions and metrics above are still computed so operators can see
+	// what would happen, but the scale target is left untouched.
+	if !a.scalingEnabled() {
+		zap.S().With(zap.String("name", a.ObjectMeta.Name)).
+			Info("Autoscaler scaling disabled by AutoscalingStrategy, skipping update")
+		return nil
+	}
+
 	existingReplicas := scaleTarget.Spec.Replicas
 	// 4. Persist updated scale to server
 	scaleTarget.Spec.Replicas = desiredReplicas
 	if err := a.updateScaleTarget(scaleTarget); err != nil {
 		return err
 	}
+	a.history.Record(a.namespacedName(), existingReplicas, desiredReplicas, time.Now())
 	zap.S().With(zap.String("existing", fmt.Sprintf("%d", existingReplicas))).
 		With(zap.String("desired", fmt.Sprintf("%d", desiredReplicas))).
 		Info("Autoscaler scaled replicas count")
-	a.Status.DesiredReplicas = &scaleTarget.Spec.Replicas
 	a.Status.LastScaleTime = &apis.VolatileTime{Inner: metav1.Now()}
 	return nil
 }
@@ -120,9 +166,12 @@ func (a *Autoscaler) getMetrics() ([]algorithms.Metric, error) {
 			return nil, fmt.Errorf("failed retrieving metric, %w", err)
 		}
 		metrics = append(metrics, algorithms.Metric{
-			Metric:      observed,
-			TargetType:  metric.GetTarget().Type,
-			TargetValue: float64(metric.GetTarget().Value.Value()),
+			Metric:           observed,
+			TargetType:       metric.GetTarget().Type,
+			TargetValue:      float64(metric.GetTarget().Value.Value()),
+			Weight:           metric.Weight,
+			DisableScaleUp:   metric.DisableScaleUp,
+			DisableScaleDown: metric.DisableScaleDown,
 		})
 	}
 	return metrics, nil
@@ -142,27 +191,43 @@ They are also orthogonal, such that {ScalingUnbounded, AbleToScale} can be
 {false, false}: limited stabilization window or policy and also by min/max.
 */
 func (a *Autoscaler) getDesiredReplicas(metrics []algorithms.Metric, scaleTarget *v1.Scale) int32 {
-	var recommendations []int32
+	currentReplicas := scaleTarget.Spec.Replicas
+	var recommendations []v1alpha1.WeightedRecommendation
 	for _, metric := range metrics {
-		recommendations = append(recommendations, a.algorithm.GetDesiredReplicas(metric, scaleTarget.Status.Replicas))
+		value := a.algorithm.GetDesiredReplicas(metric, scaleTarget.Status.Replicas)
+		if metric.DisableScaleUp && value > currentReplicas {
+			value = currentReplicas
+		}
+		if metric.DisableScaleDown && value < currentReplicas {
+			value = currentReplicas
+		}
+		recommendations = append(recommendations, v1alpha1.WeightedRecommendation{Value: value, Weight: metric.Weight})
 	}
 
-	recommendation := a.Spec.Behavior.ApplySelectPolicy(scaleTarget.Spec.Replicas, recommendations)
-	limited := a.applyTransientLimits(scaleTarget.Spec.Replicas, recommendation)
+	recommendation := a.Spec.Behavior.ApplySelectPolicy(currentReplicas, recommendations)
+	// Vote on the raw recommendation before any rate/bound limiting is
+	// applied to it, so the consecutive-threshold counter tracks what the
+	// metrics actually want rather than what a prior limiter already
+	// clipped it to. Gating happens here, orthogonally to the limits below:
+	// once the threshold is met, the (still unlimited) recommendation flows
+	// into rate and bound limiting as usual.
+	gated := a.applyConsecutiveThreshold(currentReplicas, recommendation)
+	limited := a.applyTransientLimits(scaleTarget.Spec.Replicas, gated)
 	return a.applyBoundedLimits(limited)
 }
 
 func (a *Autoscaler) applyBoundedLimits(desiredReplicas int32) int32 {
+	minReplicas, maxReplicas := a.effectiveBounds()
 	boundedReplicas := f.
 		MinInt32([]int32{f.
 			MaxInt32([]int32{
 				desiredReplicas,
-				a.Spec.MinReplicas}),
-			a.Spec.MaxReplicas})
+				minReplicas}),
+			maxReplicas})
 
 	if boundedReplicas != desiredReplicas {
 		a.StatusConditions().MarkFalse(v1alpha1.ScalingUnbounded, "",
-			fmt.Sprintf("recommendation %d limited by bounds [%d, %d]", desiredReplicas, a.Spec.MinReplicas, a.Spec.MaxReplicas))
+			fmt.Sprintf("recommendation %d limited by bounds [%d, %d]", desiredReplicas, minReplicas, maxReplicas))
 	} else {
 		a.StatusConditions().MarkTrue(v1alpha1.ScalingUnbounded)
 	}
@@ -183,17 +248,24 @@ func (a *Autoscaler) applyTransientLimits(replicas int32, recommendation int32)
 		return replicas
 	}
 
-	// 2. TODO Check if limited by Policies
-	for _, policy := range rules.Policies {
-		zap.S().Info("TODO: check policy %s", policy)
+	// 2. Check if limited by Policies
+	limited, clipped := a.applyScalingPolicies(rules, replicas, recommendation)
+	if clipped {
+		a.StatusConditions().MarkFalse(v1alpha1.AbleToScale, "", limitReason(replicas, limited, recommendation))
+		return limited
 	}
 
 	// 3. If not limited, use raw recommended value
 	a.StatusConditions().MarkTrue(v1alpha1.AbleToScale)
-	return recommendation
+	return limited
 }
 
 func (a *Autoscaler) getScaleTarget() (*v1.Scale, error) {
+	if a.cachedClient != nil && isWellKnownScaleTarget(a.Spec.ScaleTargetRef) {
+		return a.getScaleTargetFastPath(a.Spec.ScaleTargetRef)
+	}
+
+	recordScaleTargetFallback("get")
 	groupResource, err := a.parseGroupResource(a.Spec.ScaleTargetRef)
 	if err != nil {
 		return nil, fmt.Errorf("parsing group resource for %v, %w", a.Spec.ScaleTargetRef, err)
@@ -208,6 +280,11 @@ func (a *Autoscaler) getScaleTarget() (*v1.Scale, error) {
 }
 
 func (a *Autoscaler) updateScaleTarget(scaleTarget *v1.Scale) error {
+	if a.cachedClient != nil && isWellKnownScaleTarget(a.Spec.ScaleTargetRef) {
+		return a.updateScaleTargetFastPath(a.Spec.ScaleTargetRef, scaleTarget)
+	}
+
+	recordScaleTargetFallback("update")
 	groupResource, err := a.parseGroupResource(a.Spec.ScaleTargetRef)
 	if err != nil {
 		return fmt.Errorf("parsing group resource for %v, %w", a.Spec.ScaleTargetRef, err)
@@ -0,0 +1,197 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DefaultBatchWindow is how long a BatchScaler waits for additional
+// reconcile requests for the same HorizontalAutoscaler before running a
+// single Reconcile, absent an explicit window.
+const DefaultBatchWindow = 3 * time.Second
+
+var (
+	batchCoalescedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "karpenter",
+		Subsystem: "autoscaler",
+		Name:      "batch_scaler_coalesced_total",
+		Help:      "Count of reconcile requests coalesced into an already-pending batch instead of triggering a new reconcile.",
+	})
+	batchFlushedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "karpenter",
+		Subsystem: "autoscaler",
+		Name:      "batch_scaler_flushed_total",
+		Help:      "Count of pending batches reconciled early by Flush.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(batchCoalescedTotal, batchFlushedTotal)
+}
+
+// pendingReconcile is a single HorizontalAutoscaler waiting out its batch
+// window. resource is replaced on every Add so the eventual Reconcile sees
+// the most recent snapshot.
+type pendingReconcile struct {
+	resource *v1alpha1.HorizontalAutoscaler
+	timer    *time.Timer
+}
+
+// BatchScaler sits between a controller's event source and Autoscaler.Reconcile,
+// coalescing reconcile requests for the same HorizontalAutoscaler that
+// arrive within window into a single getMetrics/updateScaleTarget cycle.
+// This cuts API-server QPS in clusters where many external metric webhooks
+// or event-driven triggers fan into the same HPA target.
+type BatchScaler struct {
+	factory *Factory
+	window  time.Duration
+	// reconcileFunc performs the actual reconcile for a fired batch.
+	// Defaulted to b.reconcile by NewBatchScaler; overridable in tests so
+	// the in-flight guard can be exercised without a live API server.
+	reconcileFunc func(entry *pendingReconcile)
+
+	mu       sync.Mutex
+	pending  map[types.NamespacedName]*pendingReconcile
+	inFlight map[types.NamespacedName]bool
+}
+
+// NewBatchScaler constructs a BatchScaler that coalesces reconcile requests
+// arriving within window, using factory to build the Autoscaler for each
+// batch it fires.
+func NewBatchScaler(factory *Factory, window time.Duration) *BatchScaler {
+	if window <= 0 {
+		window = DefaultBatchWindow
+	}
+	b := &BatchScaler{
+		factory:  factory,
+		window:   window,
+		pending:  map[types.NamespacedName]*pendingReconcile{},
+		inFlight: map[types.NamespacedName]bool{},
+	}
+	b.reconcileFunc = b.reconcile
+	return b
+}
+
+// Add enqueues resource to be reconciled after the batch window elapses. If
+// a reconcile for the same namespace/name is already pending, resource
+// replaces the queued snapshot and no new timer is started.
+func (b *BatchScaler) Add(resource *v1alpha1.HorizontalAutoscaler) {
+	key := types.NamespacedName{Namespace: resource.Namespace, Name: resource.Name}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if entry, ok := b.pending[key]; ok {
+		entry.resource = resource
+		batchCoalescedTotal.Inc()
+		return
+	}
+
+	entry := &pendingReconcile{resource: resource}
+	entry.timer = time.AfterFunc(b.window, func() { b.fire(key) })
+	b.pending[key] = entry
+}
+
+// fire reconciles the batch queued for key, if it's still pending.
+func (b *BatchScaler) fire(key types.NamespacedName) {
+	entry := b.pop(key)
+	if entry == nil {
+		return
+	}
+	b.runOrRequeue(key, entry)
+}
+
+// pop removes and returns the pending batch for key, if any.
+func (b *BatchScaler) pop(key types.NamespacedName) *pendingReconcile {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	entry, ok := b.pending[key]
+	if !ok {
+		return nil
+	}
+	delete(b.pending, key)
+	return entry
+}
+
+// runOrRequeue reconciles entry unless a reconcile for key is already in
+// flight, in which case entry is put back on the pending queue to run once
+// that reconcile finishes. Without this guard, a timer firing just as a new
+// Add arrives could start a second, overlapping Reconcile for the same key.
+func (b *BatchScaler) runOrRequeue(key types.NamespacedName, entry *pendingReconcile) {
+	b.mu.Lock()
+	if b.inFlight[key] {
+		b.mu.Unlock()
+		b.requeue(key, entry)
+		return
+	}
+	b.inFlight[key] = true
+	b.mu.Unlock()
+
+	b.reconcileFunc(entry)
+
+	b.mu.Lock()
+	delete(b.inFlight, key)
+	b.mu.Unlock()
+}
+
+// requeue re-enqueues entry for key, coalescing with any batch that was
+// added while the previous reconcile for key was in flight. Such a batch
+// was added after entry was taken off the queue, so its snapshot is kept.
+func (b *BatchScaler) requeue(key types.NamespacedName, entry *pendingReconcile) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.pending[key]; ok {
+		return
+	}
+	entry.timer = time.AfterFunc(b.window, func() { b.fire(key) })
+	b.pending[key] = entry
+}
+
+func (b *BatchScaler) reconcile(entry *pendingReconcile) {
+	autoscaler := b.factory.For(entry.resource)
+	if err := autoscaler.Reconcile(); err != nil {
+		zap.S().With(zap.String("name", entry.resource.Name)).With(zap.Error(err)).
+			Error("Batched reconcile failed")
+	}
+}
+
+// Flush reconciles every pending batch immediately, without waiting for
+// their windows to elapse. It's intended for graceful shutdown. A batch
+// whose key already has a reconcile in flight is not run by Flush; it is
+// requeued to run after another window. Flush stops early if ctx is
+// cancelled, leaving any remaining batches unreconciled.
+func (b *BatchScaler) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = map[types.NamespacedName]*pendingReconcile{}
+	b.mu.Unlock()
+
+	for key, entry := range pending {
+		entry.timer.Stop()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		batchFlushedTotal.Inc()
+		b.runOrRequeue(key, entry)
+	}
+	return nil
+}
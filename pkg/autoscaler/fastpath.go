@@ -0,0 +1,166 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// wellKnownScaleTargets are the kinds KEDA-style direct-object reads are
+// wired up for, avoiding a live scales.Get/Update round-trip against the
+// API server in favor of the informer-backed cache.
+var wellKnownScaleTargets = map[schema.GroupKind]bool{
+	{Group: "apps", Kind: "Deployment"}:  true,
+	{Group: "apps", Kind: "StatefulSet"}: true,
+	{Group: "apps", Kind: "ReplicaSet"}:  true,
+}
+
+// scaleTargetPathTotal counts scale target reads/writes by operation
+// (get/update) and path (fastpath via the informer-backed cache, or
+// fallback via the scales subresource), so the two are directly
+// comparable. It intentionally doesn't track success/failure: that's a
+// property of the underlying Get/Update call, not of which path was taken.
+var scaleTargetPathTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "karpenter",
+	Subsystem: "autoscaler",
+	Name:      "scale_target_path_total",
+	Help:      "Count of scale target reads/writes by operation and whether they used the informer-backed fast path or fell back to the scales subresource.",
+}, []string{"operation", "path"})
+
+func init() {
+	prometheus.MustRegister(scaleTargetPathTotal)
+}
+
+// recordScaleTargetFallback records that operation ("get" or "update")
+// fell back to the scales subresource instead of the cached-client fast
+// path, e.g. because the scale target isn't a well-known kind.
+func recordScaleTargetFallback(operation string) {
+	scaleTargetPathTotal.WithLabelValues(operation, "fallback").Inc()
+}
+
+// isWellKnownScaleTarget reports whether ref names a kind with a direct
+// cached-client fast path.
+func isWellKnownScaleTarget(ref v1alpha1.CrossVersionObjectReference) bool {
+	groupVersion, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return false
+	}
+	return wellKnownScaleTargets[schema.GroupKind{Group: groupVersion.Group, Kind: ref.Kind}]
+}
+
+// getScaleTargetFastPath reads spec.replicas/status.replicas directly from
+// the cached client for well-known kinds instead of the scales subresource.
+func (a *Autoscaler) getScaleTargetFastPath(ref v1alpha1.CrossVersionObjectReference) (*v1.Scale, error) {
+	scaleTargetPathTotal.WithLabelValues("get", "fastpath").Inc()
+	key := types.NamespacedName{Namespace: a.ObjectMeta.Namespace, Name: ref.Name}
+	switch ref.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err := a.cachedClient.Get(context.TODO(), key, deployment); err != nil {
+			return nil, fmt.Errorf("getting cached deployment %v, %w", key, err)
+		}
+		return deploymentToScale(deployment), nil
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err := a.cachedClient.Get(context.TODO(), key, statefulSet); err != nil {
+			return nil, fmt.Errorf("getting cached statefulset %v, %w", key, err)
+		}
+		return statefulSetToScale(statefulSet), nil
+	case "ReplicaSet":
+		replicaSet := &appsv1.ReplicaSet{}
+		if err := a.cachedClient.Get(context.TODO(), key, replicaSet); err != nil {
+			return nil, fmt.Errorf("getting cached replicaset %v, %w", key, err)
+		}
+		return replicaSetToScale(replicaSet), nil
+	}
+	return nil, fmt.Errorf("%s is not a well-known scale target", ref.Kind)
+}
+
+// updateScaleTargetFastPath sets spec.replicas for well-known kinds by
+// reading the object from the cached client and writing the whole object
+// back with Update. The Scale subresource is not used, and no patch is
+// issued.
+func (a *Autoscaler) updateScaleTargetFastPath(ref v1alpha1.CrossVersionObjectReference, scaleTarget *v1.Scale) error {
+	scaleTargetPathTotal.WithLabelValues("update", "fastpath").Inc()
+	key := types.NamespacedName{Namespace: a.ObjectMeta.Namespace, Name: ref.Name}
+	switch ref.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		if err := a.cachedClient.Get(context.TODO(), key, deployment); err != nil {
+			return fmt.Errorf("getting cached deployment %v, %w", key, err)
+		}
+		deployment.Spec.Replicas = &scaleTarget.Spec.Replicas
+		return a.cachedClient.Update(context.TODO(), deployment)
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		if err := a.cachedClient.Get(context.TODO(), key, statefulSet); err != nil {
+			return fmt.Errorf("getting cached statefulset %v, %w", key, err)
+		}
+		statefulSet.Spec.Replicas = &scaleTarget.Spec.Replicas
+		return a.cachedClient.Update(context.TODO(), statefulSet)
+	case "ReplicaSet":
+		replicaSet := &appsv1.ReplicaSet{}
+		if err := a.cachedClient.Get(context.TODO(), key, replicaSet); err != nil {
+			return fmt.Errorf("getting cached replicaset %v, %w", key, err)
+		}
+		replicaSet.Spec.Replicas = &scaleTarget.Spec.Replicas
+		return a.cachedClient.Update(context.TODO(), replicaSet)
+	}
+	return fmt.Errorf("%s is not a well-known scale target", ref.Kind)
+}
+
+func deploymentToScale(deployment *appsv1.Deployment) *v1.Scale {
+	var specReplicas int32
+	if deployment.Spec.Replicas != nil {
+		specReplicas = *deployment.Spec.Replicas
+	}
+	return &v1.Scale{
+		ObjectMeta: deployment.ObjectMeta,
+		Spec:       v1.ScaleSpec{Replicas: specReplicas},
+		Status:     v1.ScaleStatus{Replicas: deployment.Status.Replicas},
+	}
+}
+
+func statefulSetToScale(statefulSet *appsv1.StatefulSet) *v1.Scale {
+	var specReplicas int32
+	if statefulSet.Spec.Replicas != nil {
+		specReplicas = *statefulSet.Spec.Replicas
+	}
+	return &v1.Scale{
+		ObjectMeta: statefulSet.ObjectMeta,
+		Spec:       v1.ScaleSpec{Replicas: specReplicas},
+		Status:     v1.ScaleStatus{Replicas: statefulSet.Status.Replicas},
+	}
+}
+
+func replicaSetToScale(replicaSet *appsv1.ReplicaSet) *v1.Scale {
+	var specReplicas int32
+	if replicaSet.Spec.Replicas != nil {
+		specReplicas = *replicaSet.Spec.Replicas
+	}
+	return &v1.Scale{
+		ObjectMeta: replicaSet.ObjectMeta,
+		Spec:       v1.ScaleSpec{Replicas: specReplicas},
+		Status:     v1.ScaleStatus{Replicas: replicaSet.Status.Replicas},
+	}
+}
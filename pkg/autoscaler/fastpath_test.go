@@ -0,0 +1,64 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsWellKnownScaleTarget(t *testing.T) {
+	cases := []struct {
+		name string
+		ref  v1alpha1.CrossVersionObjectReference
+		want bool
+	}{
+		{name: "deployment", ref: v1alpha1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment"}, want: true},
+		{name: "statefulset", ref: v1alpha1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet"}, want: true},
+		{name: "replicaset", ref: v1alpha1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "ReplicaSet"}, want: true},
+		{name: "unknown kind", ref: v1alpha1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "DaemonSet"}, want: false},
+		{name: "unknown group", ref: v1alpha1.CrossVersionObjectReference{APIVersion: "custom.io/v1", Kind: "Deployment"}, want: false},
+		{name: "unparseable apiVersion", ref: v1alpha1.CrossVersionObjectReference{APIVersion: "apps/v1/oops", Kind: "Deployment"}, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isWellKnownScaleTarget(c.ref); got != c.want {
+				t.Errorf("isWellKnownScaleTarget(%+v) = %v, want %v", c.ref, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDeploymentToScale(t *testing.T) {
+	replicas := int32(3)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "d"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{Replicas: 2},
+	}
+	scale := deploymentToScale(deployment)
+	if scale.Spec.Replicas != 3 || scale.Status.Replicas != 2 {
+		t.Fatalf("deploymentToScale() = %+v, want Spec.Replicas=3, Status.Replicas=2", scale)
+	}
+
+	unset := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "d"}}
+	if got := deploymentToScale(unset).Spec.Replicas; got != 0 {
+		t.Fatalf("deploymentToScale() with nil Spec.Replicas = %d, want 0", got)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// scaleEvent records a single change in replica count, mirroring upstream
+// HPA's scaleUpEvents/scaleDownEvents entries.
+type scaleEvent struct {
+	timestamp time.Time
+	replicas  int32
+}
+
+// scaleHistory keeps a rolling, in-memory record of replica changes per
+// HorizontalAutoscaler, used to enforce HPAScalingPolicy rate limits across
+// reconciles. It is safe for concurrent use.
+type scaleHistory struct {
+	mu         sync.Mutex
+	scaleUps   map[types.NamespacedName][]scaleEvent
+	scaleDowns map[types.NamespacedName][]scaleEvent
+}
+
+// newScaleHistory constructs an empty scaleHistory.
+func newScaleHistory() *scaleHistory {
+	return &scaleHistory{
+		scaleUps:   map[types.NamespacedName][]scaleEvent{},
+		scaleDowns: map[types.NamespacedName][]scaleEvent{},
+	}
+}
+
+// Record appends a scale event for the given HPA, recording it as a scale
+// up or scale down event depending on the sign of replicas change.
+func (s *scaleHistory) Record(key types.NamespacedName, previousReplicas, newReplicas int32, now time.Time) {
+	if newReplicas == previousReplicas {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if newReplicas > previousReplicas {
+		s.scaleUps[key] = append(s.scaleUps[key], scaleEvent{timestamp: now, replicas: newReplicas - previousReplicas})
+	} else {
+		s.scaleDowns[key] = append(s.scaleDowns[key], scaleEvent{timestamp: now, replicas: previousReplicas - newReplicas})
+	}
+}
+
+// ReplicasChangedSince sums the replica changes recorded for key since the
+// given time, pruning any entries older than maxAge in the process.
+func (s *scaleHistory) ReplicasChangedSince(key types.NamespacedName, scaleUp bool, since time.Time, maxAge time.Duration, now time.Time) int32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := s.scaleDowns
+	if scaleUp {
+		events = s.scaleUps
+	}
+	pruned := pruneEvents(events[key], now.Add(-maxAge))
+	events[key] = pruned
+
+	var total int32
+	for _, event := range pruned {
+		if event.timestamp.After(since) {
+			total += event.replicas
+		}
+	}
+	return total
+}
+
+func pruneEvents(events []scaleEvent, cutoff time.Time) []scaleEvent {
+	kept := events[:0]
+	for _, event := range events {
+		if event.timestamp.After(cutoff) {
+			kept = append(kept, event)
+		}
+	}
+	return kept
+}
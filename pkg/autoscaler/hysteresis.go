@@ -0,0 +1,117 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// voteDirection is the direction a single reconcile's recommendation moved
+// relative to the current replica count.
+type voteDirection int32
+
+const (
+	voteNeutral voteDirection = 0
+	voteUp      voteDirection = 1
+	voteDown    voteDirection = -1
+)
+
+// consecutiveVote tracks how many reconciles in a row have voted the same
+// direction for a given HPA.
+type consecutiveVote struct {
+	direction voteDirection
+	count     int32
+}
+
+// consecutiveVotes counts, per HPA, how many reconciles in a row have
+// recommended scaling the same direction. The counter resets whenever the
+// direction flips or a neutral recommendation is produced.
+type consecutiveVotes struct {
+	mu    sync.Mutex
+	votes map[types.NamespacedName]consecutiveVote
+}
+
+// newConsecutiveVotes constructs an empty consecutiveVotes store.
+func newConsecutiveVotes() *consecutiveVotes {
+	return &consecutiveVotes{votes: map[types.NamespacedName]consecutiveVote{}}
+}
+
+// Record registers a vote in the given direction for key, resetting the
+// counter if the direction changed, and returns the updated count.
+func (c *consecutiveVotes) Record(key types.NamespacedName, direction voteDirection) int32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if direction == voteNeutral {
+		delete(c.votes, key)
+		return 0
+	}
+
+	current := c.votes[key]
+	if current.direction != direction {
+		current = consecutiveVote{direction: direction}
+	}
+	current.count++
+	c.votes[key] = current
+	return current.count
+}
+
+// applyConsecutiveThreshold withholds a scale-up or scale-down
+// recommendation until it has been recommended for
+// ScaleUpConsecutiveThreshold/ScaleDownConsecutiveThreshold reconciles in a
+// row, dampening flapping caused by noisy per-reconcile metric readings.
+// It must be given the raw, pre-limit recommendation: voting on a value
+// already clipped by applyTransientLimits/applyBoundedLimits would count
+// reconciles where the metrics wanted to move further than the limiters
+// allowed as if they hadn't, under- or over-counting consecutive votes.
+func (a *Autoscaler) applyConsecutiveThreshold(replicas, recommendation int32) int32 {
+	direction := voteNeutral
+	switch {
+	case recommendation > replicas:
+		direction = voteUp
+	case recommendation < replicas:
+		direction = voteDown
+	}
+
+	threshold := int32(1)
+	word := "neutral"
+	switch direction {
+	case voteUp:
+		word = "scale-up"
+		if a.Spec.Behavior.ScaleUpConsecutiveThreshold != nil {
+			threshold = *a.Spec.Behavior.ScaleUpConsecutiveThreshold
+		}
+	case voteDown:
+		word = "scale-down"
+		if a.Spec.Behavior.ScaleDownConsecutiveThreshold != nil {
+			threshold = *a.Spec.Behavior.ScaleDownConsecutiveThreshold
+		}
+	}
+
+	count := a.votes.Record(a.namespacedName(), direction)
+	if direction != voteNeutral {
+		a.Status.ConsecutiveVotes = fmt.Sprintf("%d/%d consecutive %s votes", count, threshold, word)
+	} else {
+		a.Status.ConsecutiveVotes = ""
+	}
+
+	if direction == voteNeutral || count < threshold {
+		return replicas
+	}
+	return recommendation
+}
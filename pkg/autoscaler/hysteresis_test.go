@@ -0,0 +1,84 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestConsecutiveVotesRecord(t *testing.T) {
+	key := types.NamespacedName{Namespace: "default", Name: "votes-test"}
+	votes := newConsecutiveVotes()
+
+	if got := votes.Record(key, voteUp); got != 1 {
+		t.Fatalf("first vote up = %d, want 1", got)
+	}
+	if got := votes.Record(key, voteUp); got != 2 {
+		t.Fatalf("second vote up = %d, want 2", got)
+	}
+	if got := votes.Record(key, voteDown); got != 1 {
+		t.Fatalf("direction flip = %d, want counter reset to 1", got)
+	}
+	if got := votes.Record(key, voteNeutral); got != 0 {
+		t.Fatalf("neutral vote = %d, want 0", got)
+	}
+	if got := votes.Record(key, voteDown); got != 1 {
+		t.Fatalf("vote after neutral = %d, want counter restarted at 1", got)
+	}
+}
+
+func TestApplyConsecutiveThreshold(t *testing.T) {
+	threshold := int32(3)
+	behavior := v1alpha1.HorizontalAutoscalerBehavior{
+		ScaleUpConsecutiveThreshold:   &threshold,
+		ScaleDownConsecutiveThreshold: &threshold,
+	}
+	a := newTestAutoscaler("hysteresis-test", behavior)
+
+	// Below threshold, the recommendation is withheld.
+	for i := 0; i < 2; i++ {
+		if got := a.applyConsecutiveThreshold(10, 15); got != 10 {
+			t.Fatalf("vote %d: applyConsecutiveThreshold() = %d, want 10 (withheld)", i+1, got)
+		}
+	}
+	// Third consecutive scale-up vote reaches the threshold.
+	if got := a.applyConsecutiveThreshold(10, 15); got != 15 {
+		t.Fatalf("applyConsecutiveThreshold() = %d, want 15 once threshold is met", got)
+	}
+	if a.Status.ConsecutiveVotes == "" {
+		t.Fatalf("expected Status.ConsecutiveVotes to be populated once voting started")
+	}
+
+	// A neutral recommendation resets the counter.
+	if got := a.applyConsecutiveThreshold(10, 10); got != 10 {
+		t.Fatalf("applyConsecutiveThreshold() = %d, want 10 for a neutral recommendation", got)
+	}
+	if a.Status.ConsecutiveVotes != "" {
+		t.Fatalf("expected Status.ConsecutiveVotes to be cleared by a neutral vote, got %q", a.Status.ConsecutiveVotes)
+	}
+	if got := a.applyConsecutiveThreshold(10, 15); got != 10 {
+		t.Fatalf("applyConsecutiveThreshold() = %d, want 10: counter should have restarted after the neutral vote", got)
+	}
+}
+
+func TestApplyConsecutiveThresholdDefaultsToOne(t *testing.T) {
+	a := newTestAutoscaler("hysteresis-default-test", v1alpha1.HorizontalAutoscalerBehavior{})
+	if got := a.applyConsecutiveThreshold(10, 15); got != 15 {
+		t.Fatalf("applyConsecutiveThreshold() = %d, want 15: unset threshold should default to 1 (no hysteresis)", got)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+	f "github.com/awslabs/karpenter/pkg/utils/functional"
+)
+
+// applyScalingPolicies enforces rules.Policies, the HPA-v2-style rate
+// limits on how many replicas may be added or removed per period. It
+// returns the recommendation clamped to the effective limit, and whether
+// the limit actually clipped the recommendation.
+func (a *Autoscaler) applyScalingPolicies(rules *v1alpha1.HPAScalingRules, replicas int32, recommendation int32) (int32, bool) {
+	if rules.SelectPolicy != nil && *rules.SelectPolicy == v1alpha1.DisabledPolicySelect {
+		return replicas, replicas != recommendation
+	}
+	if len(rules.Policies) == 0 {
+		return recommendation, false
+	}
+
+	scalingUp := recommendation > replicas
+	now := time.Now()
+	key := a.namespacedName()
+	multiplierPercent := a.rateMultiplierPercent()
+	bounds := make([]int32, 0, len(rules.Policies))
+	for _, policy := range rules.Policies {
+		period := time.Duration(policy.PeriodSeconds) * time.Second
+		changed := a.history.ReplicasChangedSince(key, scalingUp, now.Add(-period), a.maxPolicyPeriod(rules), now)
+		bounds = append(bounds, policyBound(policy, replicas, changed, scalingUp, multiplierPercent))
+	}
+
+	selectPolicy := v1alpha1.MaxPolicySelect
+	if rules.SelectPolicy != nil {
+		selectPolicy = *rules.SelectPolicy
+	}
+
+	var limit int32
+	switch {
+	case scalingUp && selectPolicy == v1alpha1.MinPolicySelect:
+		limit = f.MinInt32(bounds)
+	case scalingUp:
+		limit = f.MaxInt32(bounds)
+	case !scalingUp && selectPolicy == v1alpha1.MinPolicySelect:
+		limit = f.MaxInt32(bounds)
+	default:
+		limit = f.MinInt32(bounds)
+	}
+
+	var limited int32
+	if scalingUp {
+		limited = f.MinInt32([]int32{recommendation, limit})
+	} else {
+		limited = f.MaxInt32([]int32{recommendation, limit})
+	}
+	return limited, limited != recommendation
+}
+
+// policyBound computes the replica count a single policy allows, given how
+// many replicas have already been added/removed within its period. The
+// allowed change is scaled by multiplierPercent, composing the policy with
+// any cluster-wide AutoscalingStrategy rate override.
+func policyBound(policy v1alpha1.HPAScalingPolicy, replicas, alreadyChanged int32, scalingUp bool, multiplierPercent int32) int32 {
+	periodStart := replicas - alreadyChanged
+	if !scalingUp {
+		periodStart = replicas + alreadyChanged
+	}
+
+	var allowed int32
+	switch policy.Type {
+	case v1alpha1.PercentScalingPolicy:
+		allowed = int32(math.Ceil(float64(periodStart) * float64(policy.Value) / 100))
+	default: // v1alpha1.PodsScalingPolicy
+		allowed = policy.Value
+	}
+	allowed = int32(math.Ceil(float64(allowed) * float64(multiplierPercent) / 100))
+
+	if scalingUp {
+		return periodStart + allowed
+	}
+	return periodStart - allowed
+}
+
+// maxPolicyPeriod returns the longest PeriodSeconds across rules.Policies,
+// used to decide how far back scale history needs to be retained.
+func (a *Autoscaler) maxPolicyPeriod(rules *v1alpha1.HPAScalingRules) time.Duration {
+	var maxSeconds int32
+	for _, policy := range rules.Policies {
+		if policy.PeriodSeconds > maxSeconds {
+			maxSeconds = policy.PeriodSeconds
+		}
+	}
+	return time.Duration(maxSeconds) * time.Second
+}
+
+// limitReason formats the AbleToScale message for a recommendation that was
+// clipped by a scaling policy.
+func limitReason(replicas, limited, recommendation int32) string {
+	return fmt.Sprintf("recommendation %d limited to %d by scaling policy (from %d)", recommendation, limited, replicas)
+}
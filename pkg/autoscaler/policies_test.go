@@ -0,0 +1,155 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPolicyBound(t *testing.T) {
+	cases := []struct {
+		name              string
+		policy            v1alpha1.HPAScalingPolicy
+		replicas          int32
+		alreadyChanged    int32
+		scalingUp         bool
+		multiplierPercent int32
+		want              int32
+	}{
+		{
+			name:              "pods scale up",
+			policy:            v1alpha1.HPAScalingPolicy{Type: v1alpha1.PodsScalingPolicy, Value: 4, PeriodSeconds: 60},
+			replicas:          10,
+			scalingUp:         true,
+			multiplierPercent: 100,
+			want:              14,
+		},
+		{
+			name:              "pods scale up, already changed this period",
+			policy:            v1alpha1.HPAScalingPolicy{Type: v1alpha1.PodsScalingPolicy, Value: 4, PeriodSeconds: 60},
+			replicas:          10,
+			alreadyChanged:    3,
+			scalingUp:         true,
+			multiplierPercent: 100,
+			want:              11,
+		},
+		{
+			name:              "percent scale up",
+			policy:            v1alpha1.HPAScalingPolicy{Type: v1alpha1.PercentScalingPolicy, Value: 50, PeriodSeconds: 60},
+			replicas:          10,
+			scalingUp:         true,
+			multiplierPercent: 100,
+			want:              15,
+		},
+		{
+			name:              "percent scale down",
+			policy:            v1alpha1.HPAScalingPolicy{Type: v1alpha1.PercentScalingPolicy, Value: 50, PeriodSeconds: 60},
+			replicas:          10,
+			scalingUp:         false,
+			multiplierPercent: 100,
+			want:              5,
+		},
+		{
+			name:              "pods scale up, halved by AutoscalingStrategy multiplier",
+			policy:            v1alpha1.HPAScalingPolicy{Type: v1alpha1.PodsScalingPolicy, Value: 4, PeriodSeconds: 60},
+			replicas:          10,
+			scalingUp:         true,
+			multiplierPercent: 50,
+			want:              12,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := policyBound(c.policy, c.replicas, c.alreadyChanged, c.scalingUp, c.multiplierPercent)
+			if got != c.want {
+				t.Errorf("policyBound() = %d, want %d", got, c.want)
+			}
+		})
+	}
+}
+
+func newTestAutoscaler(name string, behavior v1alpha1.HorizontalAutoscalerBehavior) *Autoscaler {
+	return &Autoscaler{
+		HorizontalAutoscaler: &v1alpha1.HorizontalAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       v1alpha1.HorizontalAutoscalerSpec{Behavior: behavior},
+		},
+		history:  newScaleHistory(),
+		votes:    newConsecutiveVotes(),
+		strategy: newStrategyStore(),
+	}
+}
+
+func TestApplyScalingPolicies(t *testing.T) {
+	selectMax := v1alpha1.MaxPolicySelect
+	rules := &v1alpha1.HPAScalingRules{
+		SelectPolicy: &selectMax,
+		Policies: []v1alpha1.HPAScalingPolicy{
+			{Type: v1alpha1.PodsScalingPolicy, Value: 2, PeriodSeconds: 60},
+		},
+	}
+
+	a := newTestAutoscaler("policy-test", v1alpha1.HorizontalAutoscalerBehavior{})
+	limited, clipped := a.applyScalingPolicies(rules, 10, 20)
+	if !clipped {
+		t.Fatalf("expected recommendation to be clipped")
+	}
+	if limited != 12 {
+		t.Fatalf("applyScalingPolicies() = %d, want 12", limited)
+	}
+
+	limited, clipped = a.applyScalingPolicies(rules, 10, 11)
+	if clipped {
+		t.Fatalf("did not expect recommendation within the policy bound to be clipped")
+	}
+	if limited != 11 {
+		t.Fatalf("applyScalingPolicies() = %d, want 11", limited)
+	}
+}
+
+func TestApplyScalingPoliciesDisabled(t *testing.T) {
+	disabled := v1alpha1.DisabledPolicySelect
+	rules := &v1alpha1.HPAScalingRules{
+		SelectPolicy: &disabled,
+		Policies: []v1alpha1.HPAScalingPolicy{
+			{Type: v1alpha1.PodsScalingPolicy, Value: 2, PeriodSeconds: 60},
+		},
+	}
+
+	a := newTestAutoscaler("policy-test-disabled", v1alpha1.HorizontalAutoscalerBehavior{})
+	limited, clipped := a.applyScalingPolicies(rules, 10, 20)
+	if !clipped || limited != 10 {
+		t.Fatalf("applyScalingPolicies() = (%d, %v), want (10, true) when the policy select is Disabled", limited, clipped)
+	}
+}
+
+// TestApplyScalingPoliciesDisabledNoPolicies covers upstream HPA's documented
+// usage of Disabled standalone, with no Policies configured: scaling in that
+// direction must still be suppressed rather than falling through to the
+// empty-Policies early return.
+func TestApplyScalingPoliciesDisabledNoPolicies(t *testing.T) {
+	disabled := v1alpha1.DisabledPolicySelect
+	rules := &v1alpha1.HPAScalingRules{SelectPolicy: &disabled}
+
+	a := newTestAutoscaler("policy-test-disabled-no-policies", v1alpha1.HorizontalAutoscalerBehavior{})
+	limited, clipped := a.applyScalingPolicies(rules, 10, 20)
+	if !clipped || limited != 10 {
+		t.Fatalf("applyScalingPolicies() = (%d, %v), want (10, true) when Disabled with no Policies configured", limited, clipped)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"sync"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// strategyStore holds the most recently observed AutoscalingStrategy, if
+// any, shared by every Autoscaler the Factory produces.
+type strategyStore struct {
+	mu       sync.RWMutex
+	strategy *v1alpha1.AutoscalingStrategy
+}
+
+func newStrategyStore() *strategyStore {
+	return &strategyStore{}
+}
+
+func (s *strategyStore) Set(strategy *v1alpha1.AutoscalingStrategy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.strategy = strategy
+}
+
+func (s *strategyStore) Get() *v1alpha1.AutoscalingStrategy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.strategy
+}
+
+// AutoscalingStrategyReconciler watches AutoscalingStrategy resources and
+// publishes the active one to every Autoscaler produced by Factory,
+// letting operators gate or throttle scaling cluster-wide without editing
+// every individual HorizontalAutoscaler.
+type AutoscalingStrategyReconciler struct {
+	Client  client.Client
+	Factory *Factory
+}
+
+// Reconcile implements controller-runtime's reconcile.Reconciler.
+func (r *AutoscalingStrategyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	strategy := &v1alpha1.AutoscalingStrategy{}
+	if err := r.Client.Get(ctx, req.NamespacedName, strategy); err != nil {
+		if errors.IsNotFound(err) {
+			r.Factory.strategy.Set(nil)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	r.Factory.strategy.Set(strategy)
+	zap.S().With(zap.String("name", strategy.Name)).With(zap.Boolp("enabled", strategy.Spec.Enabled)).
+		Info("Observed AutoscalingStrategy")
+	return ctrl.Result{}, nil
+}
+
+// scalingEnabled reports whether the active AutoscalingStrategy allows
+// scaleTarget.Spec.Replicas to be mutated. Absent a strategy, or with
+// Spec.Enabled unset, scaling is enabled.
+func (a *Autoscaler) scalingEnabled() bool {
+	strategy := a.strategy.Get()
+	return strategy == nil || strategy.Spec.Enabled == nil || *strategy.Spec.Enabled
+}
+
+// effectiveBounds returns the min/max replica bounds to enforce, applying
+// the active AutoscalingStrategy's overrides, if any, over the HPA's own
+// Spec.MinReplicas/MaxReplicas.
+func (a *Autoscaler) effectiveBounds() (int32, int32) {
+	min, max := a.Spec.MinReplicas, a.Spec.MaxReplicas
+	if strategy := a.strategy.Get(); strategy != nil {
+		if strategy.Spec.MinReplicas != nil {
+			min = *strategy.Spec.MinReplicas
+		}
+		if strategy.Spec.MaxReplicas != nil {
+			max = *strategy.Spec.MaxReplicas
+		}
+	}
+	return min, max
+}
+
+// rateMultiplierPercent returns the active AutoscalingStrategy's global
+// scaling-rate multiplier, composed with each HPA's own scaling policies.
+// Defaults to 100 (no adjustment).
+func (a *Autoscaler) rateMultiplierPercent() int32 {
+	if strategy := a.strategy.Get(); strategy != nil && strategy.Spec.ScalingRateMultiplierPercent != nil {
+		return *strategy.Spec.ScalingRateMultiplierPercent
+	}
+	return 100
+}
@@ -0,0 +1,99 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+)
+
+func boolPtr(b bool) *bool    { return &b }
+func int32Ptr(i int32) *int32 { return &i }
+
+func TestScalingEnabled(t *testing.T) {
+	cases := []struct {
+		name     string
+		strategy *v1alpha1.AutoscalingStrategy
+		want     bool
+	}{
+		{name: "no strategy", strategy: nil, want: true},
+		{name: "strategy with unset Enabled", strategy: &v1alpha1.AutoscalingStrategy{}, want: true},
+		{
+			name:     "explicitly enabled",
+			strategy: &v1alpha1.AutoscalingStrategy{Spec: v1alpha1.AutoscalingStrategySpec{Enabled: boolPtr(true)}},
+			want:     true,
+		},
+		{
+			name:     "explicitly disabled",
+			strategy: &v1alpha1.AutoscalingStrategy{Spec: v1alpha1.AutoscalingStrategySpec{Enabled: boolPtr(false)}},
+			want:     false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			a := newTestAutoscaler("strategy-test", v1alpha1.HorizontalAutoscalerBehavior{})
+			a.strategy.Set(c.strategy)
+			if got := a.scalingEnabled(); got != c.want {
+				t.Errorf("scalingEnabled() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveBounds(t *testing.T) {
+	a := newTestAutoscaler("bounds-test", v1alpha1.HorizontalAutoscalerBehavior{})
+	a.Spec.MinReplicas = 1
+	a.Spec.MaxReplicas = 10
+
+	min, max := a.effectiveBounds()
+	if min != 1 || max != 10 {
+		t.Fatalf("effectiveBounds() = (%d, %d), want (1, 10) with no active strategy", min, max)
+	}
+
+	a.strategy.Set(&v1alpha1.AutoscalingStrategy{
+		Spec: v1alpha1.AutoscalingStrategySpec{MinReplicas: int32Ptr(2), MaxReplicas: int32Ptr(5)},
+	})
+	min, max = a.effectiveBounds()
+	if min != 2 || max != 5 {
+		t.Fatalf("effectiveBounds() = (%d, %d), want (2, 5) overridden by AutoscalingStrategy", min, max)
+	}
+
+	a.strategy.Set(&v1alpha1.AutoscalingStrategy{})
+	min, max = a.effectiveBounds()
+	if min != 1 || max != 10 {
+		t.Fatalf("effectiveBounds() = (%d, %d), want (1, 10): strategy with unset bounds shouldn't override", min, max)
+	}
+}
+
+func TestRateMultiplierPercent(t *testing.T) {
+	a := newTestAutoscaler("multiplier-test", v1alpha1.HorizontalAutoscalerBehavior{})
+	if got := a.rateMultiplierPercent(); got != 100 {
+		t.Fatalf("rateMultiplierPercent() = %d, want 100 with no active strategy", got)
+	}
+
+	a.strategy.Set(&v1alpha1.AutoscalingStrategy{})
+	if got := a.rateMultiplierPercent(); got != 100 {
+		t.Fatalf("rateMultiplierPercent() = %d, want 100 when ScalingRateMultiplierPercent is unset", got)
+	}
+
+	a.strategy.Set(&v1alpha1.AutoscalingStrategy{
+		Spec: v1alpha1.AutoscalingStrategySpec{ScalingRateMultiplierPercent: int32Ptr(50)},
+	})
+	if got := a.rateMultiplierPercent(); got != 50 {
+		t.Fatalf("rateMultiplierPercent() = %d, want 50", got)
+	}
+}
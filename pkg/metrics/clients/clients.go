@@ -0,0 +1,42 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"github.com/awslabs/karpenter/pkg/apis/autoscaling/v1alpha1"
+)
+
+// Client retrieves the current value for a single metric.
+type Client interface {
+	GetCurrentValue(metric v1alpha1.Metric) (float64, error)
+}
+
+// Factory instantiates a metrics Client for a given metric spec. Every
+// metric gets the same no-op client; no resource, pods, external or object
+// metrics backend is selected.
+type Factory struct{}
+
+// For returns the no-op Client, which reports 0 for any metric.
+func (f *Factory) For(metric v1alpha1.Metric) Client {
+	return &noopClient{}
+}
+
+// noopClient is a placeholder until the concrete metrics backends
+// (resource/pods/external/object) are wired up.
+type noopClient struct{}
+
+func (n *noopClient) GetCurrentValue(metric v1alpha1.Metric) (float64, error) {
+	return 0, nil
+}